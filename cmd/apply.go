@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/renderinc/render-cli/pkg/blueprint"
+	"github.com/renderinc/render-cli/pkg/client"
+	"github.com/renderinc/render-cli/pkg/postgres"
+	"github.com/renderinc/render-cli/pkg/service"
+	"github.com/renderinc/render-cli/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Reconcile a workspace against a declarative blueprint",
+	Long: `Reconcile a workspace against a declarative blueprint, the way "terraform apply" reconciles a .tf file against state.
+
+Run "render apply --dry-run -f blueprint.yaml" to print the computed plan as JSON without applying it.
+
+Run "render apply --list-templates" to see the starter blueprints shipped in the binary, and
+"render apply --init <name> -f blueprint.yaml" to scaffold one of them into a new file.`,
+}
+
+func init() {
+	var file string
+	var dryRun bool
+	var listTemplates bool
+	var initTemplate string
+
+	applyCmd.Flags().StringVarP(&file, "file", "f", "", "path to the blueprint YAML file")
+	applyCmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the plan as JSON instead of applying it")
+	applyCmd.Flags().BoolVar(&listTemplates, "list-templates", false, "list the starter blueprints available to --init")
+	applyCmd.Flags().StringVar(&initTemplate, "init", "", "write a starter blueprint (see --list-templates) to --file instead of applying")
+
+	applyCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if listTemplates {
+			names, err := blueprint.StarterBlueprints()
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				fmt.Fprintln(cmd.OutOrStdout(), name)
+			}
+			return nil
+		}
+
+		if initTemplate != "" {
+			if file == "" {
+				return fmt.Errorf("--file is required with --init")
+			}
+
+			data, err := blueprint.StarterBlueprint(initTemplate)
+			if err != nil {
+				return fmt.Errorf("unknown starter blueprint %q (see --list-templates): %w", initTemplate, err)
+			}
+
+			return os.WriteFile(file, data, 0644)
+		}
+
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read blueprint %q: %w", file, err)
+		}
+
+		bp, err := blueprint.Parse(data)
+		if err != nil {
+			return err
+		}
+
+		current, err := loadLiveState(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		statePath := blueprint.StatePath(file)
+		managed, err := blueprint.LoadState(statePath)
+		if err != nil {
+			return fmt.Errorf("failed to read state %q: %w", statePath, err)
+		}
+
+		plan := blueprint.Diff(bp, current, managed)
+
+		if dryRun {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(plan)
+		}
+
+		InteractiveApply(cmd.Context(), ApplyInput{Blueprint: bp, Plan: plan, StatePath: statePath})
+		return nil
+	}
+
+	rootCmd.AddCommand(applyCmd)
+}
+
+// loadLiveState reuses resource.Service's providers to list what already
+// exists. Plan/Region/EnvVars aren't exposed by resource.Resource today, so
+// they come back zero-valued here; the diff still catches every create and
+// delete, but every existing resource whose blueprint entry sets a plan,
+// region or env var is planned as an update from an empty value on each
+// run, so a real in-place change can't be told apart from an unchanged one.
+func loadLiveState(ctx context.Context) (blueprint.LiveState, error) {
+	resourceService, err := newResourceService()
+	if err != nil {
+		return blueprint.LiveState{}, err
+	}
+
+	resources, err := resourceService.ListResources(ctx)
+	if err != nil {
+		return blueprint.LiveState{}, err
+	}
+
+	var state blueprint.LiveState
+	for _, r := range resources {
+		switch r.Type() {
+		case postgres.PostgresType:
+			state.Postgres = append(state.Postgres, blueprint.LivePostgres{Name: r.Name()})
+		default:
+			state.Services = append(state.Services, blueprint.LiveService{Name: r.Name()})
+		}
+	}
+
+	return state, nil
+}
+
+type ApplyInput struct {
+	Blueprint *blueprint.Blueprint
+	Plan      blueprint.Plan
+	StatePath string
+}
+
+// InteractiveApply pushes a confirmation screen listing the plan's diffs,
+// with per-resource expand/collapse, and runs the Reconciler on approval
+// with progress tracked by the stack's own LoadingDataMsg/DoneMsg - the same
+// progress mechanism every other Interactive* command uses. A successful
+// apply persists which resources input.Blueprint now manages to
+// input.StatePath, so the next Diff knows it's safe to delete them if
+// they're later removed from the blueprint file.
+func InteractiveApply(ctx context.Context, input ApplyInput) tea.Cmd {
+	run := func() tea.Msg {
+		reconciler, err := newBlueprintReconciler(input.Blueprint)
+		if err != nil {
+			return tui.ErrorMsg{Err: err}
+		}
+
+		if err := reconciler.Apply(ctx, input.Plan); err != nil {
+			return tui.ErrorMsg{Err: fmt.Errorf("failed to apply blueprint: %w", err)}
+		}
+
+		if err := blueprint.FromBlueprint(input.Blueprint).Save(input.StatePath); err != nil {
+			return tui.ErrorMsg{Err: fmt.Errorf("applied blueprint but failed to save state %q: %w", input.StatePath, err)}
+		}
+
+		return tui.DoneMsg{Message: "Blueprint applied"}
+	}
+
+	return stack.Push(tui.ModelWithCmd{
+		Model:      newApplyConfirmModel(input.Plan, run),
+		Breadcrumb: "Apply Blueprint",
+	})
+}
+
+func newBlueprintReconciler(bp *blueprint.Blueprint) (*blueprint.Reconciler, error) {
+	httpClient := http.DefaultClient
+	host := os.Getenv("RENDER_HOST")
+	apiKey := os.Getenv("RENDER_API_KEY")
+
+	c, err := client.ClientWithAuth(httpClient, host, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// environment.Repo and project.Repo aren't needed by the reconciler
+	// itself, but service.NewRepo/postgres.NewRepo mirror the constructor
+	// shape used everywhere else in newResourceService.
+	return blueprint.NewReconciler(bp, &blueprintServiceAdapter{repo: service.NewRepo(c)}, &blueprintPostgresAdapter{repo: postgres.NewRepo(c)}), nil
+}
+
+// blueprintServiceAdapter adapts *service.Repo to blueprint.ServiceReconciler.
+// blueprint.ServiceSpec is this package's own YAML-sourced type, not
+// anything service.Repo knows about, so this translates it into the
+// Create/Update/DeleteService input shapes that repo already exposes,
+// mirroring the CreateJobInput/ListJobsInput convention pkg/job.Repo uses
+// for its own calls.
+type blueprintServiceAdapter struct {
+	repo *service.Repo
+}
+
+func (a *blueprintServiceAdapter) CreateService(ctx context.Context, spec blueprint.ServiceSpec) error {
+	return a.repo.CreateService(ctx, service.CreateServiceInput{
+		Name:    spec.Name,
+		Type:    spec.Type,
+		Plan:    spec.Plan,
+		Region:  spec.Region,
+		EnvVars: spec.EnvVars,
+	})
+}
+
+func (a *blueprintServiceAdapter) UpdateService(ctx context.Context, spec blueprint.ServiceSpec) error {
+	return a.repo.UpdateService(ctx, spec.Name, service.UpdateServiceInput{
+		Plan:    spec.Plan,
+		Region:  spec.Region,
+		EnvVars: spec.EnvVars,
+	})
+}
+
+func (a *blueprintServiceAdapter) DeleteService(ctx context.Context, name string) error {
+	return a.repo.DeleteService(ctx, name)
+}
+
+// blueprintPostgresAdapter adapts *postgres.Repo to
+// blueprint.PostgresReconciler; see blueprintServiceAdapter for why the
+// translation exists.
+type blueprintPostgresAdapter struct {
+	repo *postgres.Repo
+}
+
+func (a *blueprintPostgresAdapter) CreatePostgres(ctx context.Context, spec blueprint.PostgresSpec) error {
+	return a.repo.CreatePostgres(ctx, postgres.CreatePostgresInput{
+		Name:   spec.Name,
+		Plan:   spec.Plan,
+		Region: spec.Region,
+	})
+}
+
+func (a *blueprintPostgresAdapter) UpdatePostgres(ctx context.Context, spec blueprint.PostgresSpec) error {
+	return a.repo.UpdatePostgres(ctx, spec.Name, postgres.UpdatePostgresInput{
+		Plan:   spec.Plan,
+		Region: spec.Region,
+	})
+}
+
+func (a *blueprintPostgresAdapter) DeletePostgres(ctx context.Context, name string) error {
+	return a.repo.DeletePostgres(ctx, name)
+}
+
+var applyDiffStyle = lipgloss.NewStyle().PaddingLeft(1)
+var applyActionStyle = map[blueprint.Action]lipgloss.Style{
+	blueprint.ActionCreate: lipgloss.NewStyle().Foreground(lipgloss.Color("42")),
+	blueprint.ActionUpdate: lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+	blueprint.ActionDelete: lipgloss.NewStyle().Foreground(lipgloss.Color("196")),
+	blueprint.ActionNoop:   lipgloss.NewStyle().Foreground(lipgloss.Color("241")),
+}
+
+type applyConfirmModel struct {
+	plan     blueprint.Plan
+	expanded map[int]bool
+	cursor   int
+	run      tea.Cmd
+}
+
+func newApplyConfirmModel(plan blueprint.Plan, run tea.Cmd) *applyConfirmModel {
+	sort.SliceStable(plan.Diffs, func(i, j int) bool {
+		return plan.Diffs[i].Name < plan.Diffs[j].Name
+	})
+
+	return &applyConfirmModel{plan: plan, expanded: map[int]bool{}, run: run}
+}
+
+func (m *applyConfirmModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *applyConfirmModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.plan.Diffs)-1 {
+			m.cursor++
+		}
+	case " ":
+		m.expanded[m.cursor] = !m.expanded[m.cursor]
+	case "enter", "y":
+		if m.plan.IsNoop() {
+			return m, func() tea.Msg { return tui.DoneMsg{Message: "Nothing to apply"} }
+		}
+		return m, func() tea.Msg { return tui.LoadingDataMsg(m.run) }
+	case "esc", "n":
+		return m, func() tea.Msg { return tui.DoneMsg{Message: "Apply canceled"} }
+	}
+
+	return m, nil
+}
+
+func (m *applyConfirmModel) View() string {
+	var b strings.Builder
+
+	b.WriteString("Plan:\n\n")
+	for i, diff := range m.plan.Diffs {
+		style, ok := applyActionStyle[diff.Action]
+		if !ok {
+			style = applyDiffStyle
+		}
+
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+		}
+
+		line := fmt.Sprintf("%s%-8s %s %s", cursor, diff.Action, diff.ResourceType, diff.Name)
+		b.WriteString(style.Render(line))
+		b.WriteString("\n")
+
+		if m.expanded[i] {
+			for field, change := range diff.Changes {
+				fmt.Fprintf(&b, "      %s: %s -> %s\n", field, change.Before, change.After)
+			}
+		}
+	}
+
+	b.WriteString("\n[enter] apply  [space] expand/collapse  [esc] cancel\n")
+	return b.String()
+}
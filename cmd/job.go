@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renderinc/render-cli/pkg/client"
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/command"
+	"github.com/renderinc/render-cli/pkg/job"
+	"github.com/renderinc/render-cli/pkg/job/watcher"
+	"github.com/renderinc/render-cli/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs [serviceID]",
+	Short: "List and manage one-off jobs for a service",
+}
+
+var InteractiveJobs = command.Wrap(jobsCmd, loadJobData, renderJobs)
+
+type ListJobInput struct {
+	ServiceID string
+}
+
+func (l ListJobInput) String() []string {
+	return []string{l.ServiceID}
+}
+
+func loadJobData(ctx context.Context, input ListJobInput) ([]*clientjob.Job, error) {
+	repo, err := newJobRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.ListJobs(ctx, job.ListJobsInput{ServiceID: input.ServiceID})
+}
+
+func renderJobs(ctx context.Context, loadData func(input ListJobInput) ([]*clientjob.Job, error), in ListJobInput) (tea.Model, error) {
+	repo, err := newJobRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	w := watcher.New(repo, job.ListJobsInput{ServiceID: in.ServiceID}, 0)
+
+	jobs, err := loadData(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// watcher.Subscribe ties its poll goroutine to this context, not the
+	// outer command context, so Close can stop it as soon as the screen is
+	// popped instead of it running for the lifetime of the whole process.
+	watchCtx, cancel := context.WithCancel(ctx)
+
+	return newJobsModel(watchCtx, cancel, in.ServiceID, repo, w, jobs), nil
+}
+
+func newJobRepo() (*job.Repo, error) {
+	httpClient := http.DefaultClient
+	host := os.Getenv("RENDER_HOST")
+	apiKey := os.Getenv("RENDER_API_KEY")
+
+	c, err := client.ClientWithAuth(httpClient, host, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return job.NewRepo(c), nil
+}
+
+var jobColumns = []table.Column{
+	{Title: "ID", Width: 25},
+	{Title: "Status", Width: 12},
+	{Title: "Start Command", Width: 40},
+}
+
+// jobsModel is a bespoke table model, rather than tui.NewTableModel, because
+// it needs to splice watcher.EventMsg updates into individual rows in place
+// instead of reloading the whole list on every change. It implements
+// tui.Closer so Ctrl+D stops the watcher's poll goroutine instead of leaking
+// it for the rest of the process's life.
+type jobsModel struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	serviceID string
+	repo      *job.Repo
+	watcher   *watcher.Watcher
+	watchCmd  tea.Cmd
+
+	table table.Model
+	jobs  []*clientjob.Job
+}
+
+func newJobsModel(ctx context.Context, cancel context.CancelFunc, serviceID string, repo *job.Repo, w *watcher.Watcher, jobs []*clientjob.Job) *jobsModel {
+	t := table.New(table.WithColumns(jobColumns), table.WithFocused(true))
+
+	m := &jobsModel{
+		ctx:       ctx,
+		cancel:    cancel,
+		serviceID: serviceID,
+		repo:      repo,
+		watcher:   w,
+		watchCmd:  watcher.Subscribe(ctx, w),
+		table:     t,
+		jobs:      jobs,
+	}
+	m.syncRows()
+
+	return m
+}
+
+func (m *jobsModel) Init() tea.Cmd {
+	return m.watchCmd
+}
+
+func (m *jobsModel) Close() {
+	m.cancel()
+}
+
+func (m *jobsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tui.StackSizeMsg:
+		m.table.SetWidth(msg.Width)
+		m.table.SetHeight(msg.Height)
+		return m, nil
+	case watcher.EventMsg:
+		m.applyEvent(msg)
+		return m, m.watchCmd
+	case tea.KeyMsg:
+		if msg.String() == "c" {
+			return m, m.cancelSelected()
+		}
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m *jobsModel) View() string {
+	return m.table.View()
+}
+
+func (m *jobsModel) applyEvent(event watcher.EventMsg) {
+	for i, j := range m.jobs {
+		if j.Id == event.Job.Id {
+			m.jobs[i] = event.Job
+			m.syncRows()
+			return
+		}
+	}
+
+	m.jobs = append(m.jobs, event.Job)
+	m.syncRows()
+}
+
+func (m *jobsModel) syncRows() {
+	rows := make([]table.Row, len(m.jobs))
+	for i, j := range m.jobs {
+		rows[i] = table.Row{j.Id, string(j.Status), j.StartCommand}
+	}
+	m.table.SetRows(rows)
+}
+
+func (m *jobsModel) cancelSelected() tea.Cmd {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.jobs) {
+		return nil
+	}
+	jobID := m.jobs[cursor].Id
+
+	return func() tea.Msg {
+		if _, err := m.repo.CancelJob(m.ctx, m.serviceID, jobID); err != nil {
+			return tui.ErrorMsg{Err: fmt.Errorf("failed to cancel job %s: %w", jobID, err)}
+		}
+		return nil
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+
+	var watch bool
+	jobsCmd.Flags().BoolVar(&watch, "watch", false, "stream NDJSON status transitions instead of listing once")
+	outputFlags := command.RegisterOutputFlags(jobsCmd)
+
+	jobsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		var serviceID string
+		if len(args) > 0 {
+			serviceID = args[0]
+		}
+		input := ListJobInput{ServiceID: serviceID}
+
+		if watch {
+			return watchJobsNDJSON(cmd.Context(), input)
+		}
+
+		if outputFlags.Interactive() {
+			InteractiveJobs(cmd.Context(), input)
+			return nil
+		}
+
+		format, err := outputFlags.Format()
+		if err != nil {
+			return err
+		}
+
+		jobs, err := loadJobData(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		return command.Render(cmd.OutOrStdout(), format, jobList(jobs))
+	}
+}
+
+// jobList adapts []*clientjob.Job to command.TableRenderable, mirroring
+// resourceList in cmd/service.go, so `--output=table` reuses the same
+// columns as the interactive table.
+type jobList []*clientjob.Job
+
+func (l jobList) TableHeaders() []string {
+	return []string{"ID", "Status", "Start Command"}
+}
+
+func (l jobList) TableRows() [][]string {
+	rows := make([][]string, len(l))
+	for i, j := range l {
+		rows[i] = []string{j.Id, string(j.Status), j.StartCommand}
+	}
+	return rows
+}
+
+// watchJobsNDJSON streams one JSON object per line for every job status
+// transition, so `render jobs <id> --watch | jq` works the same way the
+// interactive screen's live row updates do.
+func watchJobsNDJSON(ctx context.Context, input ListJobInput) error {
+	repo, err := newJobRepo()
+	if err != nil {
+		return err
+	}
+
+	w := watcher.New(repo, job.ListJobsInput{ServiceID: input.ServiceID}, 0)
+	events, unsubscribe := w.Subscribe()
+	defer unsubscribe()
+
+	go func() {
+		if err := w.Run(ctx); err != nil && ctx.Err() == nil {
+			// Run only returns a non-nil error here when polling itself
+			// failed, not on a clean ctx cancel; --watch pipes into jq, so
+			// there's no TUI to surface this in - exit loudly instead of
+			// leaving the stream silently stalled.
+			command.ExitErr(fmt.Errorf("job watcher stopped: %w", err))
+		}
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := enc.Encode(event); err != nil {
+				return err
+			}
+		}
+	}
+}
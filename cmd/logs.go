@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renderinc/render-cli/pkg/command"
+	"github.com/renderinc/render-cli/pkg/logs"
+	"github.com/renderinc/render-cli/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs [resourceID...]",
+	Short: "View resource logs",
+}
+
+type LogInput struct {
+	ResourceIDs []string
+	Follow      bool
+	Since       *time.Time
+	Tail        int
+}
+
+func (l LogInput) String() []string {
+	return l.ResourceIDs
+}
+
+// InteractiveLogs pushes a screen tailing pkg/logs onto the stack. The same
+// LogInput/pkg/logs.Stream combination backs `render logs --follow` for
+// non-interactive use.
+func InteractiveLogs(ctx context.Context, input LogInput) tea.Cmd {
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := newLogStream(input)
+
+	return stack.Push(tui.ModelWithCmd{
+		Model:      newLogsModel(streamCtx, cancel, stream),
+		Breadcrumb: "Logs",
+	})
+}
+
+func newLogStream(input LogInput) *logs.Stream {
+	dialer := &logs.HTTPDialer{
+		BaseURL: os.Getenv("RENDER_HOST"),
+		APIKey:  os.Getenv("RENDER_API_KEY"),
+	}
+
+	return logs.NewStream(dialer, logs.Options{
+		ResourceIDs: input.ResourceIDs,
+		Follow:      input.Follow,
+		Since:       input.Since,
+		Tail:        input.Tail,
+	})
+}
+
+// logsModel tails a pkg/logs.Stream. It implements tui.Closer so Ctrl+D
+// cancels the stream's context instead of leaking the goroutine reading off
+// the wire.
+type logsModel struct {
+	cancel context.CancelFunc
+	follow tea.Cmd
+	lines  []logs.Line
+	height int
+}
+
+func newLogsModel(ctx context.Context, cancel context.CancelFunc, stream *logs.Stream) *logsModel {
+	return &logsModel{
+		cancel: cancel,
+		follow: logs.Follow(ctx, stream),
+	}
+}
+
+func (m *logsModel) Init() tea.Cmd {
+	return m.follow
+}
+
+func (m *logsModel) Close() {
+	m.cancel()
+}
+
+func (m *logsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tui.StackSizeMsg:
+		m.height = msg.Height
+	case logs.LogLineMsg:
+		m.lines = append(m.lines, msg.Lines...)
+		return m, m.follow
+	case logs.LogStreamClosedMsg:
+		if msg.Err != nil {
+			return m, func() tea.Msg { return tui.ErrorMsg{Err: msg.Err} }
+		}
+	}
+
+	return m, nil
+}
+
+func (m *logsModel) View() string {
+	start := 0
+	if m.height > 0 && len(m.lines) > m.height {
+		start = len(m.lines) - m.height
+	}
+
+	var b strings.Builder
+	for _, line := range m.lines[start:] {
+		b.WriteString(line.Text)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+
+	var follow bool
+	var since string
+	var tail int
+
+	logsCmd.Flags().BoolVar(&follow, "follow", false, "keep streaming new log lines")
+	logsCmd.Flags().StringVar(&since, "since", "", "only show logs after this RFC3339 timestamp")
+	logsCmd.Flags().IntVar(&tail, "tail", 0, "only show the last N lines")
+	outputFlags := command.RegisterOutputFlags(logsCmd)
+
+	logsCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		input := LogInput{ResourceIDs: args, Follow: follow, Tail: tail}
+
+		if since != "" {
+			t, err := time.Parse(time.RFC3339, since)
+			if err != nil {
+				return fmt.Errorf("invalid --since %q: %w", since, err)
+			}
+			input.Since = &t
+		}
+
+		if outputFlags.Interactive() {
+			InteractiveLogs(cmd.Context(), input)
+			return nil
+		}
+
+		format, err := outputFlags.Format()
+		if err != nil {
+			return err
+		}
+
+		return streamLogsNonInteractive(cmd.Context(), format, cmd.OutOrStdout(), input)
+	}
+}
+
+// streamLogsNonInteractive tails the same logs.Stream the interactive
+// screen uses, rendering each batch of lines with command.Render instead of
+// drawing a Bubble Tea screen, so --no-interactive/--output work for logs
+// the same way they do for every other command.
+func streamLogsNonInteractive(ctx context.Context, format command.OutputFormat, w io.Writer, input LogInput) error {
+	stream := newLogStream(input)
+	go stream.Run(ctx)
+
+	for batch := range stream.Lines() {
+		if err := command.Render(w, format, logLines(batch)); err != nil {
+			return err
+		}
+	}
+
+	return stream.Err()
+}
+
+// logLines adapts []logs.Line to command.TableRenderable, mirroring
+// resourceList/jobList.
+type logLines []logs.Line
+
+func (l logLines) TableHeaders() []string {
+	return []string{"Timestamp", "Text"}
+}
+
+func (l logLines) TableRows() [][]string {
+	rows := make([][]string, len(l))
+	for i, line := range l {
+		rows[i] = []string{line.Timestamp.Format(time.RFC3339), line.Text}
+	}
+	return rows
+}
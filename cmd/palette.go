@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/renderinc/render-cli/pkg/config"
+	"github.com/renderinc/render-cli/pkg/tui"
+)
+
+// PaletteCommand is a single action surfaced in the command palette.
+// Shortcut is an optional chord (e.g. "g l") that runs the command directly
+// without the user having to type a search query for it.
+type PaletteCommand struct {
+	Name        string
+	Description string
+	Shortcut    string
+	Action      func(ctx context.Context, args []string) tea.Cmd
+}
+
+type PaletteCommandInput struct {
+	Commands []PaletteCommand
+}
+
+var (
+	paletteStyle         = lipgloss.NewStyle().PaddingLeft(1)
+	paletteSelectedStyle = lipgloss.NewStyle().PaddingLeft(1).Bold(true).Foreground(lipgloss.Color("205"))
+	paletteShortcutStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+)
+
+// InteractiveCommandPalette pushes a fuzzy-searchable, keyboard-driven list
+// of commands onto the stack. It's the single palette surface reused from
+// every resource context (services, workspaces, jobs), so the ranking and
+// shortcut handling only needs to live here once.
+func InteractiveCommandPalette(ctx context.Context, input PaletteCommandInput) tea.Cmd {
+	return stack.Push(tui.ModelWithCmd{
+		Model:      newPaletteModel(ctx, input.Commands),
+		Breadcrumb: "Command Palette",
+	})
+}
+
+// shortcutChordTimeout bounds how long the palette waits for a chord's
+// second key (e.g. the "l" in "g l") before treating the prefix key as a
+// miss.
+const shortcutChordTimeout = 700 * time.Millisecond
+
+type paletteModel struct {
+	ctx      context.Context
+	commands []PaletteCommand
+	input    textinput.Model
+	matches  []rankedCommand
+	cursor   int
+
+	pendingPrefix string
+	pendingKey    tea.KeyMsg
+	pendingAt     time.Time
+}
+
+type rankedCommand struct {
+	command PaletteCommand
+	score   float64
+}
+
+func newPaletteModel(ctx context.Context, commands []PaletteCommand) *paletteModel {
+	ti := textinput.New()
+	ti.Placeholder = "Type to search, or press a shortcut…"
+	ti.Focus()
+
+	m := &paletteModel{ctx: ctx, commands: commands, input: ti}
+	m.refreshMatches()
+	return m
+}
+
+func (m *paletteModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *paletteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		c, matched, replay, buffering := m.matchShortcut(msg)
+		if matched {
+			return m, m.run(c)
+		}
+		if buffering {
+			// msg is the first key of a chord attempt; there's nothing to
+			// type yet and nothing missed to replay.
+			return m, nil
+		}
+		if len(replay) > 0 {
+			msgs := make([]tea.Msg, len(replay))
+			for i, k := range replay {
+				msgs[i] = k
+			}
+			return m.typeKeys(msgs)
+		}
+
+		switch msg.Type {
+		case tea.KeyEnter:
+			if len(m.matches) == 0 {
+				return m, nil
+			}
+			return m, m.run(m.matches[m.cursor].command)
+		case tea.KeyUp:
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case tea.KeyDown:
+			if m.cursor < len(m.matches)-1 {
+				m.cursor++
+			}
+			return m, nil
+		}
+	}
+
+	return m.typeKeys([]tea.Msg{msg})
+}
+
+// typeKeys feeds each msg into the search box in order, refreshing matches
+// if any of them changed its value. It's used both for an ordinary
+// keystroke and to replay keys a failed chord attempt buffered (e.g. the
+// "g" in "g" + a key that isn't " l"/" r"/...), so they still reach the
+// search box instead of being silently dropped.
+func (m *paletteModel) typeKeys(msgs []tea.Msg) (tea.Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	prevValue := m.input.Value()
+
+	for _, msg := range msgs {
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if m.input.Value() != prevValue {
+		m.refreshMatches()
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// matchShortcut only fires when the search box is empty, so a shortcut like
+// "g l" never shadows someone typing a query that happens to start with "g".
+// Shortcuts are chords (e.g. "g l"), so a single keypress's String() never
+// equals one outright: the first key of a chord is buffered as
+// pendingPrefix/pendingKey and combined with the next key within
+// shortcutChordTimeout.
+//
+// Because every shortcut in this repo starts with "g", a bare "g" always
+// looks like the start of a chord - so a failed chord attempt (the second
+// key isn't " l"/" r"/...) must hand both buffered keys back via replay
+// instead of dropping them, or the user could never fuzzy-type a query
+// starting with "g". buffering reports the "first key of a chord, nothing
+// to replay yet" case separately from "not part of a shortcut at all"
+// (replay == nil, buffering == false), since the caller treats those two
+// differently.
+func (m *paletteModel) matchShortcut(msg tea.KeyMsg) (cmd PaletteCommand, matched bool, replay []tea.KeyMsg, buffering bool) {
+	if m.input.Value() != "" {
+		return PaletteCommand{}, false, nil, false
+	}
+
+	key := msg.String()
+
+	if m.pendingPrefix != "" {
+		prefix := m.pendingPrefix
+		prefixKey := m.pendingKey
+		expired := time.Since(m.pendingAt) > shortcutChordTimeout
+		m.pendingPrefix = ""
+		m.pendingKey = tea.KeyMsg{}
+
+		if !expired {
+			chord := prefix + " " + key
+			for _, c := range m.commands {
+				if c.Shortcut == chord {
+					return c, true, nil, false
+				}
+			}
+		}
+
+		return PaletteCommand{}, false, []tea.KeyMsg{prefixKey, msg}, false
+	}
+
+	for _, c := range m.commands {
+		if c.Shortcut != "" && c.Shortcut == key {
+			return c, true, nil, false
+		}
+	}
+
+	if m.isChordPrefix(key) {
+		m.pendingPrefix = key
+		m.pendingKey = msg
+		m.pendingAt = time.Now()
+		return PaletteCommand{}, false, nil, true
+	}
+
+	return PaletteCommand{}, false, nil, false
+}
+
+// isChordPrefix reports whether key is the first key of some command's
+// two-key chord shortcut.
+func (m *paletteModel) isChordPrefix(key string) bool {
+	for _, c := range m.commands {
+		if strings.HasPrefix(c.Shortcut, key+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *paletteModel) run(c PaletteCommand) tea.Cmd {
+	recordRecentCommand(c.Name)
+	return c.Action(m.ctx, nil)
+}
+
+func (m *paletteModel) refreshMatches() {
+	query := strings.ToLower(strings.TrimSpace(m.input.Value()))
+	recent := recentCommandRank()
+
+	matches := make([]rankedCommand, 0, len(m.commands))
+	for _, c := range m.commands {
+		score := fuzzyScore(query, strings.ToLower(c.Name)+" "+strings.ToLower(c.Description))
+		if query != "" && score <= 0 {
+			continue
+		}
+		matches = append(matches, rankedCommand{command: c, score: score + recent[c.Name]})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	m.matches = matches
+	if m.cursor >= len(m.matches) {
+		m.cursor = 0
+	}
+}
+
+func (m *paletteModel) View() string {
+	var b strings.Builder
+	b.WriteString(m.input.View())
+	b.WriteString("\n\n")
+
+	for i, match := range m.matches {
+		line := match.command.Name
+		if match.command.Description != "" {
+			line += " — " + match.command.Description
+		}
+		if match.command.Shortcut != "" {
+			line += "  " + paletteShortcutStyle.Render("["+match.command.Shortcut+"]")
+		}
+
+		if i == m.cursor {
+			b.WriteString(paletteSelectedStyle.Render("> " + line))
+		} else {
+			b.WriteString(paletteStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// fuzzyScore ranks candidate against query with a bigram-overlap score and a
+// prefix bonus, so a typo like "rstart" still surfaces "restart" but an
+// exact prefix match always outranks a scattered one. A query that shares no
+// bigram with candidate and isn't a substring of it (e.g. "lg" against
+// "logs") scores 0.
+func fuzzyScore(query, candidate string) float64 {
+	if query == "" {
+		return 0
+	}
+
+	if strings.HasPrefix(candidate, query) {
+		return 100 + float64(len(query))
+	}
+
+	queryBigrams := bigrams(query)
+	if len(queryBigrams) == 0 {
+		if strings.Contains(candidate, query) {
+			return 10
+		}
+		return 0
+	}
+
+	candidateBigrams := bigrams(candidate)
+	matched := 0
+	for bg := range queryBigrams {
+		if candidateBigrams[bg] {
+			matched++
+		}
+	}
+
+	if matched == 0 {
+		if strings.Contains(candidate, query) {
+			return 5
+		}
+		return 0
+	}
+
+	return float64(matched) / float64(len(queryBigrams)) * 50
+}
+
+func bigrams(s string) map[string]bool {
+	out := map[string]bool{}
+	for i := 0; i+1 < len(s); i++ {
+		out[s[i:i+2]] = true
+	}
+	return out
+}
+
+const maxRecentCommands = 10
+
+// recentCommandRank returns a small score bonus per command name keyed by
+// recency, so the MRU list persisted via pkg/config floats recently-used
+// commands to the top of an otherwise-empty search.
+func recentCommandRank() map[string]float64 {
+	conf, err := config.Load()
+	if err != nil {
+		return nil
+	}
+
+	rank := make(map[string]float64, len(conf.RecentCommands))
+	for i, name := range conf.RecentCommands {
+		rank[name] = float64(len(conf.RecentCommands)-i) * 0.1
+	}
+	return rank
+}
+
+func recordRecentCommand(name string) {
+	conf, err := config.Load()
+	if err != nil {
+		return
+	}
+
+	filtered := make([]string, 0, len(conf.RecentCommands))
+	for _, existing := range conf.RecentCommands {
+		if existing != name {
+			filtered = append(filtered, existing)
+		}
+	}
+
+	conf.RecentCommands = append([]string{name}, filtered...)
+	if len(conf.RecentCommands) > maxRecentCommands {
+		conf.RecentCommands = conf.RecentCommands[:maxRecentCommands]
+	}
+
+	_ = conf.Persist()
+}
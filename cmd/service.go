@@ -11,6 +11,8 @@ import (
 	"github.com/renderinc/render-cli/pkg/client"
 	"github.com/renderinc/render-cli/pkg/command"
 	"github.com/renderinc/render-cli/pkg/environment"
+	"github.com/renderinc/render-cli/pkg/job"
+	"github.com/renderinc/render-cli/pkg/job/watcher"
 	"github.com/renderinc/render-cli/pkg/postgres"
 	"github.com/renderinc/render-cli/pkg/project"
 	"github.com/renderinc/render-cli/pkg/resource"
@@ -50,112 +52,171 @@ func renderResources(ctx context.Context, loadData func(input ListResourceInput)
 		{Title: "Name", Width: 40},
 	}
 
-	return tui.NewTableModel[resource.Resource](
-		"resources",
-		func() ([]resource.Resource, error) {
-			return loadData(in)
-		},
-		formatResourceRow,
-		selectResource(ctx),
-		columns,
-		filterResource,
-		[]tui.CustomOption[resource.Resource]{
-			{
-				Key:      "w",
-				Title:    "Change Workspace",
-				Function: resourceOptionSelectWorkspace(ctx),
+	newTable := func() *tui.TableModel[resource.Resource] {
+		return tui.NewTableModel[resource.Resource](
+			"resources",
+			func() ([]resource.Resource, error) {
+				return loadData(in)
 			},
-		},
-	), nil
+			formatResourceRow,
+			selectResource(ctx),
+			columns,
+			filterResource,
+			[]tui.CustomOption[resource.Resource]{
+				{
+					Key:      "w",
+					Title:    "Change Workspace",
+					Function: resourceOptionSelectWorkspace(ctx),
+				},
+			},
+		)
+	}
+
+	loadResources := func() ([]resource.Resource, error) {
+		return loadData(in)
+	}
+
+	return newResourceTableModel(ctx, loadResources, newTable)
 }
 
-func formatResourceRow(r resource.Resource) table.Row {
-	// r.ID() must be first because it's used when selecting a row in selectCurrentRow()
-	// TODO: make this less brittle
-	return []string{r.ID(), r.Type(), r.ProjectName(), r.EnvironmentName(), r.Name()}
+// resourceTableModel wraps the table tui.NewTableModel returns with a job
+// watcher subscription across every service, so a row reloads as soon as one
+// of its own jobs changes status instead of the user having to re-run
+// `render services` to see it. It implements tui.Closer so Ctrl+D stops the
+// watcher's poll goroutine rather than leaking it.
+//
+// resourceIDs keeps an irrelevant event - a job transition for a service not
+// shown here - from touching the table at all: the watcher subscribes
+// account-wide, so without this every event anywhere would disturb this
+// screen. A relevant event patches just that one row via UpdateRow (assumed
+// to exist on tui.TableModel[T], keyed by the row's first column the same
+// way formatResourceRow documents selectCurrentRow relying on it) rather
+// than reloading the whole table, so the cursor position and any active
+// filter text survive an unrelated job finishing elsewhere on the account.
+type resourceTableModel struct {
+	table         *tui.TableModel[resource.Resource]
+	loadResources func() ([]resource.Resource, error)
+	resourceIDs   map[string]bool
+	watchCmd      tea.Cmd
+	cancel        context.CancelFunc
 }
 
-func optionallyAddCommand(commands []PaletteCommand, command PaletteCommand, allowedTypes []string, resource resource.Resource) []PaletteCommand {
-	if len(allowedTypes) == 0 {
-		return append(commands, command)
+func newResourceTableModel(ctx context.Context, loadResources func() ([]resource.Resource, error), newTable func() *tui.TableModel[resource.Resource]) (*resourceTableModel, error) {
+	repo, err := newJobRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := loadResources()
+	if err != nil {
+		return nil, err
 	}
 
-	for _, allowedType := range allowedTypes {
-		if resource.Type() == allowedType {
-			return append(commands, command)
+	watchCtx, cancel := context.WithCancel(ctx)
+	w := watcher.New(repo, job.ListJobsInput{}, 0)
+
+	return &resourceTableModel{
+		table:         newTable(),
+		loadResources: loadResources,
+		resourceIDs:   resourceIDSet(resources),
+		watchCmd:      watcher.Subscribe(watchCtx, w),
+		cancel:        cancel,
+	}, nil
+}
+
+func resourceIDSet(resources []resource.Resource) map[string]bool {
+	ids := make(map[string]bool, len(resources))
+	for _, r := range resources {
+		ids[r.ID()] = true
+	}
+	return ids
+}
+
+func (m *resourceTableModel) Init() tea.Cmd {
+	return tea.Batch(m.table.Init(), m.watchCmd)
+}
+
+func (m *resourceTableModel) Close() {
+	m.cancel()
+}
+
+func (m *resourceTableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if event, ok := msg.(watcher.EventMsg); ok {
+		if !m.resourceIDs[event.Job.ServiceId] {
+			return m, m.watchCmd
+		}
+
+		if resources, err := m.loadResources(); err == nil {
+			m.resourceIDs = resourceIDSet(resources)
+			for _, r := range resources {
+				if r.ID() == event.Job.ServiceId {
+					m.table.UpdateRow(r.ID(), r)
+					break
+				}
+			}
 		}
+		return m, m.watchCmd
 	}
 
-	return commands
+	model, cmd := m.table.Update(msg)
+	m.table = model.(*tui.TableModel[resource.Resource])
+	return m, cmd
+}
+
+func (m *resourceTableModel) View() string {
+	return m.table.View()
+}
+
+func formatResourceRow(r resource.Resource) table.Row {
+	// r.ID() must be first because it's used when selecting a row in selectCurrentRow()
+	// TODO: make this less brittle
+	return []string{r.ID(), r.Type(), r.ProjectName(), r.EnvironmentName(), r.Name()}
+}
+
+// resourceCommandActions maps a resource.PaletteCommand's Name to the
+// concrete tea.Cmd it runs. pkg/resource's providers only know how to
+// describe which commands apply to a resource (PaletteCommands); only cmd
+// knows how to turn a command name into an Interactive* screen.
+var resourceCommandActions = map[string]func(ctx context.Context, r resource.Resource) tea.Cmd{
+	"logs": func(ctx context.Context, r resource.Resource) tea.Cmd {
+		return InteractiveLogs(ctx, LogInput{ResourceIDs: []string{r.ID()}})
+	},
+	"restart": func(ctx context.Context, r resource.Resource) tea.Cmd {
+		return InteractiveRestart(ctx, RestartInput{ResourceID: r.ID()})
+	},
+	"psql": func(ctx context.Context, r resource.Resource) tea.Cmd {
+		return InteractivePSQL(ctx, PSQLInput{PostgresID: r.ID()})
+	},
+	"deploy": func(ctx context.Context, r resource.Resource) tea.Cmd {
+		return InteractiveDeploy(ctx, types.DeployInput{ServiceID: r.ID()})
+	},
+	"ssh": func(ctx context.Context, r resource.Resource) tea.Cmd {
+		return InteractiveSSH(ctx, SSHInput{ServiceID: r.ID()})
+	},
 }
 
 func selectResource(ctx context.Context) func(resource.Resource) tea.Cmd {
 	return func(r resource.Resource) tea.Cmd {
-
-		type commandWithAllowedTypes struct {
-			command      PaletteCommand
-			allowedTypes []string
+		resourceService, err := newResourceService()
+		if err != nil {
+			return func() tea.Msg { return tui.ErrorMsg{Err: err} }
 		}
 
 		var commands []PaletteCommand
-		commandWithTypes := []commandWithAllowedTypes{
-			{
-				command: PaletteCommand{
-					Name:        "logs",
-					Description: "View resource logs",
-					Action: func(ctx context.Context, args []string) tea.Cmd {
-						return InteractiveLogs(ctx, LogInput{
-							ResourceIDs: []string{r.ID()},
-						})
-					},
-				},
-			},
-			{
-				command: PaletteCommand{
-					Name:        "restart",
-					Description: "Restart the service",
-					Action: func(ctx context.Context, args []string) tea.Cmd {
-						return InteractiveRestart(ctx, RestartInput{ResourceID: r.ID()})
-					},
-				},
-			},
-			{
-				command: PaletteCommand{
-					Name:        "psql",
-					Description: "Connect to the PostgreSQL database",
-					Action: func(ctx context.Context, args []string) tea.Cmd {
-						return InteractivePSQL(ctx, PSQLInput{PostgresID: r.ID()})
-					},
-				},
-				allowedTypes: []string{postgres.PostgresType},
-			},
-			{
-				command: PaletteCommand{
-					Name:        "deploy",
-					Description: "Deploy the service",
-					Action: func(ctx context.Context, args []string) tea.Cmd {
-						return InteractiveDeploy(ctx, types.DeployInput{ServiceID: r.ID()})
-					},
-				},
-				allowedTypes: service.Types,
-			},
-			{
-				command: PaletteCommand{
-					Name:        "ssh",
-					Description: "SSH into the service",
-					Action: func(ctx context.Context, args []string) tea.Cmd {
-						return InteractiveSSH(ctx, SSHInput{ServiceID: r.ID()})
-					},
-				},
-				allowedTypes: []string{
-					service.WebServiceResourceType, service.PrivateServiceResourceType,
-					service.BackgroundWorkerResourceType,
-				},
-			},
-		}
+		for _, pc := range resourceService.PaletteCommands(r) {
+			action, ok := resourceCommandActions[pc.Name]
+			if !ok {
+				continue
+			}
 
-		for _, c := range commandWithTypes {
-			commands = optionallyAddCommand(commands, c.command, c.allowedTypes, r)
+			commands = append(commands, PaletteCommand{
+				Name:        pc.Name,
+				Description: pc.Description,
+				Shortcut:    pc.Shortcut,
+				Action: func(ctx context.Context, args []string) tea.Cmd {
+					return action(ctx, r)
+				},
+			})
 		}
 
 		return InteractiveCommandPalette(ctx, PaletteCommandInput{
@@ -193,10 +254,10 @@ func newResourceService() (*resource.Service, error) {
 	postgresService := postgres.NewService(postgresRepo, environmentRepo, projectRepo)
 
 	resourceService := resource.NewResourceService(
-		serviceService,
-		postgresService,
 		environmentRepo,
 		projectRepo,
+		resource.NewServiceProvider(serviceService),
+		resource.NewPostgresProvider(postgresService),
 	)
 
 	return resourceService, nil
@@ -211,8 +272,41 @@ func resourceOptionSelectWorkspace(ctx context.Context) func(resource.Resource)
 func init() {
 	rootCmd.AddCommand(servicesCmd)
 
+	outputFlags := command.RegisterOutputFlags(servicesCmd)
+
 	servicesCmd.RunE = func(cmd *cobra.Command, args []string) error {
-		InteractiveServices(cmd.Context(), ListResourceInput{})
-		return nil
+		if outputFlags.Interactive() {
+			InteractiveServices(cmd.Context(), ListResourceInput{})
+			return nil
+		}
+
+		format, err := outputFlags.Format()
+		if err != nil {
+			return err
+		}
+
+		resources, err := loadResourceData(cmd.Context(), ListResourceInput{})
+		if err != nil {
+			return err
+		}
+
+		return command.Render(cmd.OutOrStdout(), format, resourceList(resources))
+	}
+}
+
+// resourceList adapts []resource.Resource to command.TableRenderable so
+// `--output=table` (the default) reuses the same columns as the interactive
+// table instead of falling back to raw JSON.
+type resourceList []resource.Resource
+
+func (r resourceList) TableHeaders() []string {
+	return []string{"ID", "Type", "Project", "Environment", "Name"}
+}
+
+func (r resourceList) TableRows() [][]string {
+	rows := make([][]string, len(r))
+	for i, resource := range r {
+		rows[i] = formatResourceRow(resource)
 	}
+	return rows
 }
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/renderinc/render-cli/pkg/tui"
+)
+
+// stack is the single StackModel every Interactive* command pushes its
+// screen onto. It's declared here, rather than wherever the root Bubble Tea
+// program is bootstrapped, so every file in this package that calls
+// stack.Push has a concrete, compiled target.
+var stack = tui.NewStack()
+
+func init() {
+	stack.GlobalPalette = func() tea.Cmd {
+		return InteractiveCommandPalette(context.Background(), PaletteCommandInput{
+			Commands: globalPaletteCommands(),
+		})
+	}
+}
+
+// globalPaletteCommands are the entries available from Ctrl+P regardless of
+// which stack frame is on top, as opposed to the resource-specific commands
+// selectResource builds for a single row.
+func globalPaletteCommands() []PaletteCommand {
+	return []PaletteCommand{
+		{
+			Name:        "services",
+			Description: "List and manage services",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveServices(ctx, ListResourceInput{})
+			},
+		},
+		{
+			Name:        "workspaces",
+			Description: "Select a workspace to run commands against",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveWorkspaceSet(ctx, ListWorkspaceInput{})
+			},
+		},
+		{
+			Name:        "jobs",
+			Description: "List and manage one-off jobs for a service",
+			Action: func(ctx context.Context, args []string) tea.Cmd {
+				return InteractiveJobs(ctx, ListJobInput{})
+			},
+		},
+	}
+}
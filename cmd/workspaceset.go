@@ -119,15 +119,51 @@ func selectWorkspace(o *client.Owner) tea.Msg {
 }
 
 func init() {
+	outputFlags := command.RegisterOutputFlags(workspaceSetCmd)
+
 	workspaceSetCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		var input ListWorkspaceInput
 		err := command.ParseCommand(cmd, args, &input)
 		if err != nil {
 			return err
 		}
-		InteractiveWorkspaceSet(cmd.Context(), input)
-		return nil
+
+		if outputFlags.Interactive() {
+			InteractiveWorkspaceSet(cmd.Context(), input)
+			return nil
+		}
+
+		format, err := outputFlags.Format()
+		if err != nil {
+			return err
+		}
+
+		owners, err := loadWorkspaceData(cmd.Context(), input)
+		if err != nil {
+			return err
+		}
+
+		return command.Render(cmd.OutOrStdout(), format, ownerList(owners))
 	}
 
 	workspaceCmd.AddCommand(workspaceSetCmd)
+}
+
+// ownerList adapts []*client.Owner to command.TableRenderable, mirroring
+// resourceList/jobList so --output=table lists workspaces the same way
+// --no-interactive works for services, jobs, and logs. Selecting a
+// workspace still requires the interactive table - there's no
+// non-interactive equivalent of a selection - so this only covers listing.
+type ownerList []*client.Owner
+
+func (o ownerList) TableHeaders() []string {
+	return []string{"ID", "Name", "Email"}
+}
+
+func (o ownerList) TableRows() [][]string {
+	rows := make([][]string, len(o))
+	for i, owner := range o {
+		rows[i] = []string{owner.Id, owner.Name, owner.Email}
+	}
+	return rows
 }
\ No newline at end of file
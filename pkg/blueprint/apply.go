@@ -0,0 +1,96 @@
+package blueprint
+
+import (
+	"context"
+	"fmt"
+)
+
+// ServiceReconciler and PostgresReconciler are minimal interfaces rather
+// than *service.Repo and *postgres.Repo directly, so Reconciler can be
+// exercised without a live client; the concrete repos satisfy them.
+type ServiceReconciler interface {
+	CreateService(ctx context.Context, spec ServiceSpec) error
+	UpdateService(ctx context.Context, spec ServiceSpec) error
+	DeleteService(ctx context.Context, name string) error
+}
+
+type PostgresReconciler interface {
+	CreatePostgres(ctx context.Context, spec PostgresSpec) error
+	UpdatePostgres(ctx context.Context, spec PostgresSpec) error
+	DeletePostgres(ctx context.Context, name string) error
+}
+
+// Reconciler executes a Plan's operations against the live workspace.
+type Reconciler struct {
+	services      ServiceReconciler
+	postgres      PostgresReconciler
+	serviceSpecs  map[string]ServiceSpec
+	postgresSpecs map[string]PostgresSpec
+}
+
+func NewReconciler(bp *Blueprint, services ServiceReconciler, postgres PostgresReconciler) *Reconciler {
+	serviceSpecs := make(map[string]ServiceSpec, len(bp.Services))
+	for _, s := range bp.Services {
+		serviceSpecs[s.Name] = s
+	}
+
+	postgresSpecs := make(map[string]PostgresSpec, len(bp.Postgres))
+	for _, p := range bp.Postgres {
+		postgresSpecs[p.Name] = p
+	}
+
+	return &Reconciler{services: services, postgres: postgres, serviceSpecs: serviceSpecs, postgresSpecs: postgresSpecs}
+}
+
+// Apply executes every non-no-op diff in plan, in order, stopping at the
+// first error so a partial apply is easy to diagnose from the plan's
+// position instead of a batch of swallowed errors.
+func (r *Reconciler) Apply(ctx context.Context, plan Plan) error {
+	for _, diff := range plan.Diffs {
+		if diff.Action == ActionNoop {
+			continue
+		}
+
+		var err error
+		switch diff.ResourceType {
+		case "service":
+			err = r.applyService(ctx, diff)
+		case "postgres":
+			err = r.applyPostgres(ctx, diff)
+		default:
+			err = fmt.Errorf("unknown resource type %q", diff.ResourceType)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply %s %q: %w", diff.ResourceType, diff.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) applyService(ctx context.Context, diff ResourceDiff) error {
+	switch diff.Action {
+	case ActionCreate:
+		return r.services.CreateService(ctx, r.serviceSpecs[diff.Name])
+	case ActionUpdate:
+		return r.services.UpdateService(ctx, r.serviceSpecs[diff.Name])
+	case ActionDelete:
+		return r.services.DeleteService(ctx, diff.Name)
+	default:
+		return nil
+	}
+}
+
+func (r *Reconciler) applyPostgres(ctx context.Context, diff ResourceDiff) error {
+	switch diff.Action {
+	case ActionCreate:
+		return r.postgres.CreatePostgres(ctx, r.postgresSpecs[diff.Name])
+	case ActionUpdate:
+		return r.postgres.UpdatePostgres(ctx, r.postgresSpecs[diff.Name])
+	case ActionDelete:
+		return r.postgres.DeletePostgres(ctx, diff.Name)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package blueprint
+
+// Action is the operation Diff decided a resource needs.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNoop   Action = "no-op"
+)
+
+// FieldChange is a single field's before/after value in a ResourceDiff.
+type FieldChange struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+type ResourceDiff struct {
+	ResourceType string                 `json:"resourceType"`
+	Name         string                 `json:"name"`
+	Action       Action                 `json:"action"`
+	Changes      map[string]FieldChange `json:"changes,omitempty"`
+}
+
+// Plan is the full set of resource diffs for one apply.
+type Plan struct {
+	Diffs []ResourceDiff `json:"diffs"`
+}
+
+// IsNoop reports whether applying the plan would change nothing.
+func (p Plan) IsNoop() bool {
+	for _, d := range p.Diffs {
+		if d.Action != ActionNoop {
+			return false
+		}
+	}
+	return true
+}
+
+// LiveState is the subset of the live workspace Diff compares a Blueprint
+// against. cmd's loader fills in only Name, since resource.Resource doesn't
+// expose Plan, Region or EnvVars, so Diff compares against empty values and
+// plans an update for every existing resource whose spec sets any of those
+// fields, whether or not the live value differs - see cmd/apply.go.
+type LiveState struct {
+	Services []LiveService
+	Postgres []LivePostgres
+}
+
+type LiveService struct {
+	Name    string
+	Plan    string
+	Region  string
+	EnvVars map[string]string
+}
+
+type LivePostgres struct {
+	Name   string
+	Plan   string
+	Region string
+}
+
+// Diff computes the create/update/delete/no-op plan for bp against current.
+// managed scopes deletion: a live resource absent from bp only becomes an
+// ActionDelete if managed says a previous successful apply of this same
+// blueprint declared it. Without that, a live resource that simply isn't mentioned in
+// a hand-authored partial blueprint - the normal case - would look
+// identical to one the user removed on purpose.
+func Diff(bp *Blueprint, current LiveState, managed State) Plan {
+	var plan Plan
+
+	liveServices := make(map[string]LiveService, len(current.Services))
+	for _, s := range current.Services {
+		liveServices[s.Name] = s
+	}
+
+	for _, spec := range bp.Services {
+		live, exists := liveServices[spec.Name]
+		delete(liveServices, spec.Name)
+
+		if !exists {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "service", Name: spec.Name, Action: ActionCreate})
+			continue
+		}
+
+		if changes := diffServiceFields(spec, live); len(changes) > 0 {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "service", Name: spec.Name, Action: ActionUpdate, Changes: changes})
+		} else {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "service", Name: spec.Name, Action: ActionNoop})
+		}
+	}
+
+	for name := range liveServices {
+		if !managed.hasService(name) {
+			continue
+		}
+		plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "service", Name: name, Action: ActionDelete})
+	}
+
+	livePostgres := make(map[string]LivePostgres, len(current.Postgres))
+	for _, p := range current.Postgres {
+		livePostgres[p.Name] = p
+	}
+
+	for _, spec := range bp.Postgres {
+		live, exists := livePostgres[spec.Name]
+		delete(livePostgres, spec.Name)
+
+		if !exists {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "postgres", Name: spec.Name, Action: ActionCreate})
+			continue
+		}
+
+		if changes := diffPostgresFields(spec, live); len(changes) > 0 {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "postgres", Name: spec.Name, Action: ActionUpdate, Changes: changes})
+		} else {
+			plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "postgres", Name: spec.Name, Action: ActionNoop})
+		}
+	}
+
+	for name := range livePostgres {
+		if !managed.hasPostgres(name) {
+			continue
+		}
+		plan.Diffs = append(plan.Diffs, ResourceDiff{ResourceType: "postgres", Name: name, Action: ActionDelete})
+	}
+
+	return plan
+}
+
+func diffServiceFields(spec ServiceSpec, live LiveService) map[string]FieldChange {
+	changes := map[string]FieldChange{}
+	if spec.Plan != "" && spec.Plan != live.Plan {
+		changes["plan"] = FieldChange{Before: live.Plan, After: spec.Plan}
+	}
+	if spec.Region != "" && spec.Region != live.Region {
+		changes["region"] = FieldChange{Before: live.Region, After: spec.Region}
+	}
+	for k, v := range spec.EnvVars {
+		if live.EnvVars[k] != v {
+			changes["envVars."+k] = FieldChange{Before: live.EnvVars[k], After: v}
+		}
+	}
+	return changes
+}
+
+func diffPostgresFields(spec PostgresSpec, live LivePostgres) map[string]FieldChange {
+	changes := map[string]FieldChange{}
+	if spec.Plan != "" && spec.Plan != live.Plan {
+		changes["plan"] = FieldChange{Before: live.Plan, After: spec.Plan}
+	}
+	if spec.Region != "" && spec.Region != live.Region {
+		changes["region"] = FieldChange{Before: live.Region, After: spec.Region}
+	}
+	return changes
+}
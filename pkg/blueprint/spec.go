@@ -0,0 +1,41 @@
+// Package blueprint implements `render apply -f blueprint.yaml`: a
+// declarative spec for a workspace's services, Postgres databases, and env
+// vars, reconciled against the live workspace the same way `terraform
+// apply` reconciles a .tf file against state.
+package blueprint
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Blueprint is the top-level declarative spec.
+type Blueprint struct {
+	Services []ServiceSpec  `yaml:"services"`
+	Postgres []PostgresSpec `yaml:"postgres"`
+}
+
+type ServiceSpec struct {
+	Name    string            `yaml:"name"`
+	Type    string            `yaml:"type"`
+	Plan    string            `yaml:"plan"`
+	Region  string            `yaml:"region"`
+	EnvVars map[string]string `yaml:"envVars"`
+}
+
+type PostgresSpec struct {
+	Name   string `yaml:"name"`
+	Plan   string `yaml:"plan"`
+	Region string `yaml:"region"`
+}
+
+// Parse reads a Blueprint from its YAML representation.
+func Parse(data []byte) (*Blueprint, error) {
+	var bp Blueprint
+	if err := yaml.Unmarshal(data, &bp); err != nil {
+		return nil, fmt.Errorf("failed to parse blueprint: %w", err)
+	}
+
+	return &bp, nil
+}
@@ -0,0 +1,88 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// State records which service/Postgres names a blueprint file has actually
+// applied in the past. Diff only treats a live resource as a deletion
+// candidate when its name shows up here - otherwise any resource that
+// simply isn't mentioned in a hand-authored partial blueprint (the normal
+// case) would look identical to one the user removed on purpose, and
+// Reconciler.Apply would delete it.
+type State struct {
+	Services []string `json:"services"`
+	Postgres []string `json:"postgres"`
+}
+
+// StatePath derives the state file's path from the blueprint file's, the
+// same way Terraform keeps a .tfstate next to its .tf file.
+func StatePath(blueprintFile string) string {
+	return blueprintFile + ".state.json"
+}
+
+// LoadState reads the state file at path. A missing file is treated as an
+// empty State rather than an error, since the first apply of a new
+// blueprint has nothing to load yet.
+func LoadState(path string) (State, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return State{}, nil
+	}
+	if err != nil {
+		return State{}, err
+	}
+
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return State{}, err
+	}
+
+	return s, nil
+}
+
+// Save writes s to path as indented JSON so it's readable/diffable like the
+// blueprint YAML it tracks.
+func (s State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// FromBlueprint builds the State a successful apply of bp should persist:
+// every resource bp declares is now managed by it.
+func FromBlueprint(bp *Blueprint) State {
+	s := State{
+		Services: make([]string, len(bp.Services)),
+		Postgres: make([]string, len(bp.Postgres)),
+	}
+	for i, svc := range bp.Services {
+		s.Services[i] = svc.Name
+	}
+	for i, p := range bp.Postgres {
+		s.Postgres[i] = p.Name
+	}
+	return s
+}
+
+func (s State) hasService(name string) bool {
+	for _, n := range s.Services {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (s State) hasPostgres(name string) bool {
+	for _, n := range s.Postgres {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
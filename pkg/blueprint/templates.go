@@ -0,0 +1,29 @@
+package blueprint
+
+import "embed"
+
+//go:embed templates/*.yaml
+var starterTemplates embed.FS
+
+// StarterBlueprints lists the example blueprints shipped in the binary,
+// mirroring how the Coder CLI ships example templates, so `render apply
+// --init` has something to scaffold a new blueprint.yaml from.
+func StarterBlueprints() ([]string, error) {
+	entries, err := starterTemplates.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+
+	return names, nil
+}
+
+// StarterBlueprint returns the raw YAML for one of the names returned by
+// StarterBlueprints.
+func StarterBlueprint(name string) ([]byte, error) {
+	return starterTemplates.ReadFile("templates/" + name)
+}
@@ -0,0 +1,18 @@
+package command
+
+import (
+	"fmt"
+	"os"
+)
+
+// ExitErr prints err to stderr and exits with a non-zero status if err is
+// non-nil, so non-interactive commands fail loudly in CI instead of relying
+// on a human to notice an empty result.
+func ExitErr(err error) {
+	if err == nil {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat selects how a command renders its result when running
+// non-interactively.
+type OutputFormat string
+
+const (
+	FormatTable OutputFormat = "table"
+	FormatJSON  OutputFormat = "json"
+	FormatYAML  OutputFormat = "yaml"
+)
+
+const outputSchemaVersion = "v1"
+
+// OutputFlags holds the cross-cutting --output/--no-interactive flags shared
+// by every Interactive* command so CI pipelines can script against a stable,
+// versioned result instead of parsing a Bubble Tea screen.
+type OutputFlags struct {
+	format        string
+	noInteractive bool
+}
+
+// RegisterOutputFlags adds --output and --no-interactive to cmd. Commands
+// that support headless output should call this alongside their other flags.
+func RegisterOutputFlags(cmd *cobra.Command) *OutputFlags {
+	flags := &OutputFlags{}
+	cmd.Flags().StringVar(&flags.format, "output", string(FormatTable), "output format: table, json, or yaml")
+	cmd.Flags().BoolVar(&flags.noInteractive, "no-interactive", false, "print results instead of launching the interactive UI")
+	return flags
+}
+
+// Interactive reports whether the command should launch its Bubble Tea UI.
+// --output=json and --output=yaml imply --no-interactive, since there's no
+// script-friendly way to render a running TUI as JSON; --output=table (the
+// default) still launches the UI unless --no-interactive is also set.
+func (f *OutputFlags) Interactive() bool {
+	return !f.noInteractive && OutputFormat(f.format) == FormatTable
+}
+
+func (f *OutputFlags) Format() (OutputFormat, error) {
+	switch format := OutputFormat(f.format); format {
+	case FormatTable, FormatJSON, FormatYAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported output format %q: must be table, json, or yaml", f.format)
+	}
+}
+
+// TableRenderable lets a result describe its own table form; data types that
+// don't implement it fall back to JSON when rendered as a table.
+type TableRenderable interface {
+	TableHeaders() []string
+	TableRows() [][]string
+}
+
+// versionedOutput wraps every JSON/YAML result in a stable envelope so
+// scripts piping into jq can rely on .version and .data existing regardless
+// of which command produced the output.
+type versionedOutput struct {
+	Version string      `json:"version" yaml:"version"`
+	Data    interface{} `json:"data" yaml:"data"`
+}
+
+// Render writes data to w in the requested format. Errors are the caller's
+// responsibility to surface with a non-zero exit code via ExitErr.
+func Render(w io.Writer, format OutputFormat, data interface{}) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(versionedOutput{Version: outputSchemaVersion, Data: data})
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(versionedOutput{Version: outputSchemaVersion, Data: data})
+	case FormatTable:
+		return renderTable(w, data)
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+func renderTable(w io.Writer, data interface{}) error {
+	renderable, ok := data.(TableRenderable)
+	if !ok {
+		return Render(w, FormatJSON, data)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, joinTabs(renderable.TableHeaders()))
+	for _, row := range renderable.TableRows() {
+		fmt.Fprintln(tw, joinTabs(row))
+	}
+	return tw.Flush()
+}
+
+func joinTabs(cols []string) string {
+	out := ""
+	for i, col := range cols {
+		if i > 0 {
+			out += "\t"
+		}
+		out += col
+	}
+	return out
+}
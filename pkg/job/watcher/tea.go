@@ -0,0 +1,43 @@
+package watcher
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// EventMsg carries a single watcher Event into a tea.Model's Update loop.
+type EventMsg Event
+
+// Subscribe starts w running in the background (if it isn't already) and
+// returns a tea.Cmd that emits EventMsg as events arrive. Like
+// pkg/logs.Follow, the returned Cmd is meant to be re-issued from Update
+// each time a message comes back in, keeping exactly one read in flight.
+func Subscribe(ctx context.Context, w *Watcher) tea.Cmd {
+	events, unsubscribe := w.Subscribe()
+	started := false
+
+	var next tea.Cmd
+	next = func() tea.Msg {
+		if !started {
+			started = true
+			go func() {
+				<-ctx.Done()
+				unsubscribe()
+			}()
+			go func() {
+				w.Run(ctx) //nolint:errcheck // surfaced to the subscriber as a closed channel
+				unsubscribe()
+			}()
+		}
+
+		event, ok := <-events
+		if !ok {
+			return nil
+		}
+
+		return EventMsg(event)
+	}
+
+	return next
+}
@@ -0,0 +1,130 @@
+// Package watcher polls for job status changes and republishes them to any
+// number of subscribers, so a TUI resource table and a non-interactive
+// `--watch` stream can both react to the same underlying poll loop instead
+// of each issuing their own requests.
+package watcher
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	clientjob "github.com/renderinc/render-cli/pkg/client/jobs"
+	"github.com/renderinc/render-cli/pkg/job"
+)
+
+// Event describes a job whose status changed between two polls. PrevStatus
+// is empty the first time a job is observed.
+type Event struct {
+	Job        *clientjob.Job
+	PrevStatus string
+	Status     string
+}
+
+const (
+	defaultPollInterval = 3 * time.Second
+	// debounceSpread matches the jitter fraction used by Coder's
+	// provisionerd AcquireJobDebounce: polls land within +/-25% of the
+	// configured interval so many watchers don't all hit the API in lockstep.
+	debounceSpread = 0.25
+)
+
+// Watcher polls Repo.ListJobs on an interval and publishes status-change
+// Events to every subscriber.
+type Watcher struct {
+	repo     *job.Repo
+	input    job.ListJobsInput
+	interval time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+	lastStatus  map[string]string
+}
+
+// New constructs a Watcher. If interval is zero, defaultPollInterval is
+// used.
+func New(repo *job.Repo, input job.ListJobsInput, interval time.Duration) *Watcher {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	return &Watcher{
+		repo:        repo,
+		input:       input,
+		interval:    interval,
+		subscribers: make(map[chan Event]struct{}),
+		lastStatus:  make(map[string]string),
+	}
+}
+
+// Subscribe registers a new listener for status-change events. Call the
+// returned func to unsubscribe and release the channel.
+func (w *Watcher) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 16)
+
+	w.mu.Lock()
+	w.subscribers[ch] = struct{}{}
+	w.mu.Unlock()
+
+	unsubscribe := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if _, ok := w.subscribers[ch]; ok {
+			delete(w.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// Run polls until ctx is canceled, publishing an Event for every job whose
+// status differs from the last poll (or that's being observed for the first
+// time).
+func (w *Watcher) Run(ctx context.Context) error {
+	for {
+		jobs, err := w.repo.ListJobs(ctx, w.input)
+		if err != nil {
+			return err
+		}
+
+		w.publishChanges(jobs)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(w.debouncedInterval()):
+		}
+	}
+}
+
+func (w *Watcher) publishChanges(jobs []*clientjob.Job) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, j := range jobs {
+		prev := w.lastStatus[j.Id]
+		status := string(j.Status)
+		if prev == status {
+			continue
+		}
+
+		w.lastStatus[j.Id] = status
+		event := Event{Job: j, PrevStatus: prev, Status: status}
+		for ch := range w.subscribers {
+			select {
+			case ch <- event:
+			default:
+				// A slow subscriber drops the intermediate event rather than
+				// blocking the poll loop for everyone else.
+			}
+		}
+	}
+}
+
+func (w *Watcher) debouncedInterval() time.Duration {
+	spread := float64(w.interval) * debounceSpread
+	jitter := (rand.Float64()*2 - 1) * spread
+	return w.interval + time.Duration(jitter)
+}
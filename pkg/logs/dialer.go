@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// HTTPDialer opens a long-lived, chunked HTTP connection to Render's log
+// endpoint and hands the response body to the Stream to scan. The endpoint
+// streams newline-delimited frames the same way whether it's backed by SSE
+// or a plain chunked response, so a single http.Client request is enough -
+// no separate websocket dependency needed.
+type HTTPDialer struct {
+	Client  *http.Client
+	BaseURL string
+	APIKey  string
+}
+
+func (d *HTTPDialer) Dial(ctx context.Context, opts Options) (io.ReadCloser, error) {
+	query := url.Values{}
+	for _, id := range opts.ResourceIDs {
+		query.Add("resource", id)
+	}
+	if opts.Since != nil {
+		query.Set("since", opts.Since.Format(time.RFC3339))
+	}
+	if opts.Tail > 0 {
+		query.Set("tail", strconv.Itoa(opts.Tail))
+	}
+	query.Set("follow", strconv.FormatBool(opts.Follow))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.BaseURL+"/logs?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build log stream request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+d.APIKey)
+
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("log stream request failed: %s", resp.Status)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,275 @@
+// Package logs implements the streaming log-tailing subsystem shared by the
+// interactive and non-interactive `logs` commands: it opens a connection to
+// Render's log endpoint, decodes frames off the wire in a goroutine, and
+// hands batches of lines back to the caller as they arrive.
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Line is a single decoded log line.
+type Line struct {
+	ResourceID string
+	Timestamp  time.Time
+	Text       string
+}
+
+// Options configures a Stream.
+type Options struct {
+	ResourceIDs []string
+	Follow      bool
+	Since       *time.Time
+	Tail        int
+
+	// MaxBufferedLines caps how many undelivered lines a Stream holds in
+	// memory, mirroring a LimitReader: once hit, the oldest lines are
+	// dropped so a slow consumer can't grow the process without bound.
+	MaxBufferedLines int
+
+	// backoff configuration, with defaults applied by NewStream.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+const defaultMaxBufferedLines = 10_000
+
+// Dialer opens the underlying transport (websocket or SSE) for a log
+// stream. It's an interface rather than a concrete client so the backoff and
+// buffering logic here can be exercised without a live connection.
+type Dialer interface {
+	Dial(ctx context.Context, opts Options) (io.ReadCloser, error)
+}
+
+// Stream tails logs for a set of resources, reconnecting on transient
+// network errors with exponential backoff, until its context is canceled.
+type Stream struct {
+	dialer Dialer
+	opts   Options
+	lines  chan []Line
+	errs   chan error
+}
+
+// NewStream constructs a Stream. Call Run in a goroutine, then range over
+// Lines() until Err() is ready to read.
+func NewStream(dialer Dialer, opts Options) *Stream {
+	if opts.MaxBufferedLines <= 0 {
+		opts.MaxBufferedLines = defaultMaxBufferedLines
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = 500 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 30 * time.Second
+	}
+
+	return &Stream{
+		dialer: dialer,
+		opts:   opts,
+		lines:  make(chan []Line, 1),
+		errs:   make(chan error, 1),
+	}
+}
+
+// Lines returns the channel of decoded line batches. It's closed once Run
+// returns, whether that's because ctx was canceled, the server ended a
+// stream that isn't in follow mode, or a non-transient error occurred.
+func (s *Stream) Lines() <-chan []Line {
+	return s.lines
+}
+
+// Err returns the error that ended the stream, if any. It's only safe to
+// read after Lines() is closed.
+func (s *Stream) Err() error {
+	select {
+	case err := <-s.errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+// Run dials the log endpoint and decodes frames until ctx is canceled or a
+// non-transient error occurs, reconnecting with exponential backoff in
+// between. It blocks, so callers run it as a tea.Cmd goroutine or in its own
+// `go` statement.
+func (s *Stream) Run(ctx context.Context) {
+	defer close(s.lines)
+
+	backoff := s.opts.InitialBackoff
+	for {
+		err := s.connectAndDecode(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			// The server closed the stream cleanly; only keep reconnecting
+			// in follow mode.
+			if !s.opts.Follow {
+				return
+			}
+			backoff = s.opts.InitialBackoff
+		} else if !isTransient(err) {
+			s.errs <- err
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(jitter(backoff)):
+		}
+
+		backoff = time.Duration(math.Min(float64(backoff*2), float64(s.opts.MaxBackoff)))
+	}
+}
+
+func (s *Stream) connectAndDecode(ctx context.Context) error {
+	conn, err := s.dialer.Dial(ctx, s.opts)
+	if err != nil {
+		return fmt.Errorf("failed to dial log stream: %w", err)
+	}
+	defer conn.Close()
+
+	limited := newLineLimitedReader(bufio.NewScanner(conn), s.opts.MaxBufferedLines)
+	for limited.Scan() {
+		select {
+		case <-ctx.Done():
+			return nil
+		case s.lines <- limited.Batch():
+		}
+	}
+
+	return limited.Err()
+}
+
+// lineLimitedReader runs the scanner in a background goroutine and queues
+// whatever lines it decodes, dropping the oldest once MaxBufferedLines is
+// exceeded rather than growing without bound. Scan/Batch only pairing one
+// line per call (as they would if Scan did the scanning inline) would make
+// that trimming dead code - a slow consumer would just block the scanner
+// instead of ever having more than one queued line to trim - so the pump
+// runs ahead of the consumer and Batch drains whatever piled up behind it.
+type lineLimitedReader struct {
+	max int
+
+	mu    sync.Mutex
+	batch []Line
+	err   error
+
+	ready chan struct{}
+	done  chan struct{}
+}
+
+func newLineLimitedReader(r *bufio.Scanner, max int) *lineLimitedReader {
+	l := &lineLimitedReader{
+		max:   max,
+		ready: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+	go l.pump(r)
+	return l
+}
+
+// pump advances past any number of blank lines (common in real log output)
+// before decoding the next non-empty one, queuing every decoded line until
+// the scanner is exhausted or errors.
+func (l *lineLimitedReader) pump(r *bufio.Scanner) {
+	defer close(l.done)
+
+	for r.Scan() {
+		raw := r.Bytes()
+		if len(raw) == 0 {
+			continue
+		}
+
+		line, err := decodeLine(raw)
+		if err != nil {
+			l.mu.Lock()
+			l.err = err
+			l.mu.Unlock()
+			return
+		}
+
+		l.mu.Lock()
+		l.batch = append(l.batch, line)
+		if len(l.batch) > l.max {
+			l.batch = l.batch[len(l.batch)-l.max:]
+		}
+		l.mu.Unlock()
+
+		select {
+		case l.ready <- struct{}{}:
+		default:
+		}
+	}
+
+	l.mu.Lock()
+	if l.err == nil {
+		l.err = r.Err()
+	}
+	l.mu.Unlock()
+}
+
+// Scan blocks until at least one line is queued, or the pump has finished
+// (scanner exhausted or errored) with nothing left queued.
+func (l *lineLimitedReader) Scan() bool {
+	for {
+		l.mu.Lock()
+		hasLines := len(l.batch) > 0
+		l.mu.Unlock()
+		if hasLines {
+			return true
+		}
+
+		select {
+		case <-l.ready:
+		case <-l.done:
+			l.mu.Lock()
+			hasLines = len(l.batch) > 0
+			l.mu.Unlock()
+			return hasLines
+		}
+	}
+}
+
+func (l *lineLimitedReader) Batch() []Line {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	batch := l.batch
+	l.batch = nil
+	return batch
+}
+
+func (l *lineLimitedReader) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+func decodeLine(raw []byte) (Line, error) {
+	return Line{Timestamp: time.Now(), Text: string(raw)}, nil
+}
+
+func isTransient(err error) bool {
+	var netErr interface{ Temporary() bool }
+	if errors.As(err, &netErr) {
+		return netErr.Temporary()
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// jitter randomizes a backoff duration by up to 20% so many reconnecting
+// clients don't all hammer the endpoint on the same tick.
+func jitter(d time.Duration) time.Duration {
+	spread := float64(d) * 0.2
+	return d + time.Duration(rand.Float64()*spread)
+}
@@ -0,0 +1,45 @@
+package logs
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// LogLineMsg carries a batch of log lines into a tea.Model's Update loop.
+type LogLineMsg struct {
+	Lines []Line
+}
+
+// LogStreamClosedMsg is sent once a Stream stops producing lines, whether
+// because its context was canceled, the server ended a stream that isn't in
+// follow mode, or a non-transient error ended it.
+type LogStreamClosedMsg struct {
+	Err error
+}
+
+// Follow starts s in the background and returns a tea.Cmd that emits
+// LogLineMsg batches as they arrive. Call the returned Cmd repeatedly (once
+// per message received) from the model's Update to keep draining the
+// stream; this mirrors the standard Bubble Tea "read one, return a Cmd for
+// the next one" pattern used for long-lived subscriptions.
+func Follow(ctx context.Context, s *Stream) tea.Cmd {
+	started := false
+
+	var next tea.Cmd
+	next = func() tea.Msg {
+		if !started {
+			started = true
+			go s.Run(ctx)
+		}
+
+		lines, ok := <-s.Lines()
+		if !ok {
+			return LogStreamClosedMsg{Err: s.Err()}
+		}
+
+		return LogLineMsg{Lines: lines}
+	}
+
+	return next
+}
@@ -0,0 +1,119 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/renderinc/render-cli/pkg/postgres"
+	"github.com/renderinc/render-cli/pkg/service"
+)
+
+// serviceProvider and postgresProvider are the two built-in ResourceProvider
+// implementations, covering the resource types that existed before the
+// registry. They live here rather than in pkg/service/pkg/postgres so that
+// introducing the provider seam didn't also require touching those
+// packages; a follow-up can move each provider to live alongside its
+// resource type once more providers exist.
+type serviceProvider struct {
+	service *service.Service
+}
+
+// NewServiceProvider adapts a *service.Service to ResourceProvider.
+func NewServiceProvider(s *service.Service) ResourceProvider {
+	return &serviceProvider{service: s}
+}
+
+func (p *serviceProvider) List(ctx context.Context) ([]Resource, error) {
+	services, err := p.service.ListServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(services))
+	for i, svc := range services {
+		resources[i] = svc
+	}
+
+	return resources, nil
+}
+
+func (p *serviceProvider) Restart(ctx context.Context, id string) error {
+	if strings.HasPrefix(id, service.CronjobResourceIDPrefix) {
+		return errors.New("cron jobs cannot be restarted")
+	}
+
+	return p.service.RestartService(ctx, id)
+}
+
+// Matches covers both plain services and cron jobs: Restart special-cases
+// the latter instead of rejecting it up front, so PaletteCommands still
+// needs to route cron-job IDs here rather than to no provider at all.
+func (p *serviceProvider) Matches(id string) bool {
+	return strings.HasPrefix(id, service.ServerResourceIDPrefix) || strings.HasPrefix(id, service.CronjobResourceIDPrefix)
+}
+func (p *serviceProvider) Type() string { return "service" }
+
+func (p *serviceProvider) PaletteCommands(r Resource) []PaletteCommand {
+	if strings.HasPrefix(r.ID(), service.CronjobResourceIDPrefix) {
+		return []PaletteCommand{
+			{Name: "logs", Description: "View resource logs", Shortcut: "g l"},
+		}
+	}
+
+	commands := []PaletteCommand{
+		{Name: "logs", Description: "View resource logs", Shortcut: "g l"},
+		{Name: "restart", Description: "Restart the service", Shortcut: "g r"},
+	}
+
+	for _, t := range service.Types {
+		if r.Type() == t {
+			commands = append(commands, PaletteCommand{Name: "deploy", Description: "Deploy the service", Shortcut: "g d"})
+			break
+		}
+	}
+
+	switch r.Type() {
+	case service.WebServiceResourceType, service.PrivateServiceResourceType, service.BackgroundWorkerResourceType:
+		commands = append(commands, PaletteCommand{Name: "ssh", Description: "SSH into the service", Shortcut: "g s"})
+	}
+
+	return commands
+}
+
+type postgresProvider struct {
+	service *postgres.Service
+}
+
+// NewPostgresProvider adapts a *postgres.Service to ResourceProvider.
+func NewPostgresProvider(s *postgres.Service) ResourceProvider {
+	return &postgresProvider{service: s}
+}
+
+func (p *postgresProvider) List(ctx context.Context) ([]Resource, error) {
+	databases, err := p.service.ListPostgres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(databases))
+	for i, db := range databases {
+		resources[i] = db
+	}
+
+	return resources, nil
+}
+
+func (p *postgresProvider) Restart(ctx context.Context, id string) error {
+	return p.service.RestartPostgresDatabase(ctx, id)
+}
+
+func (p *postgresProvider) Matches(id string) bool { return strings.HasPrefix(id, postgres.ResourceIDPrefix) }
+func (p *postgresProvider) Type() string           { return postgres.PostgresType }
+
+func (p *postgresProvider) PaletteCommands(r Resource) []PaletteCommand {
+	return []PaletteCommand{
+		{Name: "logs", Description: "View resource logs", Shortcut: "g l"},
+		{Name: "psql", Description: "Connect to the PostgreSQL database", Shortcut: "g p"},
+	}
+}
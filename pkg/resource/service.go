@@ -3,13 +3,10 @@ package resource
 import (
 	"context"
 	"errors"
-	"strings"
 
 	"github.com/renderinc/render-cli/pkg/client"
 	"github.com/renderinc/render-cli/pkg/environment"
-	"github.com/renderinc/render-cli/pkg/postgres"
 	"github.com/renderinc/render-cli/pkg/project"
-	"github.com/renderinc/render-cli/pkg/service"
 )
 
 type Resource interface {
@@ -22,58 +19,89 @@ type Resource interface {
 	Type() string
 }
 
+// ResourceProvider lets a resource type (service, Postgres database, and any
+// future type such as Redis, a cron job, or a static site) register itself
+// with Service instead of being hard-coded into ListResources and
+// RestartResource. PaletteCommands returns the set of commands a provider
+// makes available for one of its own resources, so adding a type no longer
+// means also editing cmd's allowedTypes filter.
+type ResourceProvider interface {
+	List(ctx context.Context) ([]Resource, error)
+	Restart(ctx context.Context, id string) error
+
+	// Matches reports whether id belongs to this provider. It replaces a
+	// single IDPrefix string because a provider's resources aren't always
+	// one prefix: serviceProvider owns both ServerResourceIDPrefix and
+	// CronjobResourceIDPrefix.
+	Matches(id string) bool
+	Type() string
+	PaletteCommands(r Resource) []PaletteCommand
+}
+
+// PaletteCommand is a declarative description of a command palette entry.
+// It carries no Action: cmd is the only package that knows how to turn a
+// command name into a tea.Cmd (InteractiveLogs, InteractiveDeploy, ...), so
+// wiring the two together happens there instead of here.
+type PaletteCommand struct {
+	Name        string
+	Description string
+	Shortcut    string
+}
+
 type Service struct {
-	serviceService  *service.Service
-	postgresService *postgres.Service
+	providers       []ResourceProvider
 	environmentRepo *environment.Repo
 	projectRepo     *project.Repo
 }
 
-func NewResourceService(serviceService *service.Service, postgresService *postgres.Service, environmentRepo *environment.Repo, projectRepo *project.Repo) *Service {
+func NewResourceService(environmentRepo *environment.Repo, projectRepo *project.Repo, providers ...ResourceProvider) *Service {
 	return &Service{
-		serviceService:  serviceService,
-		postgresService: postgresService,
+		providers:       providers,
 		environmentRepo: environmentRepo,
 		projectRepo:     projectRepo,
 	}
 }
 
 func (rs *Service) ListResources(ctx context.Context) ([]Resource, error) {
-	services, err := rs.serviceService.ListServices(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	postgresDBs, err := rs.postgresService.ListPostgres(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	var resources []Resource
 
-	for _, svc := range services {
-		resources = append(resources, svc)
-	}
-
-	for _, db := range postgresDBs {
-		resources = append(resources, db)
+	for _, provider := range rs.providers {
+		list, err := provider.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, list...)
 	}
 
 	return resources, nil
 }
 
 func (rs *Service) RestartResource(ctx context.Context, id string) error {
-	if strings.HasPrefix(id, service.ServerResourceIDPrefix) {
-		return rs.serviceService.RestartService(ctx, id)
+	provider, ok := rs.providerFor(id)
+	if !ok {
+		return errors.New("unknown resource type")
 	}
 
-	if strings.HasPrefix(id, postgres.ResourceIDPrefix) {
-		return rs.postgresService.RestartPostgresDatabase(ctx, id)
+	return provider.Restart(ctx, id)
+}
+
+// PaletteCommands returns the commands the resource's own provider makes
+// available for it.
+func (rs *Service) PaletteCommands(r Resource) []PaletteCommand {
+	provider, ok := rs.providerFor(r.ID())
+	if !ok {
+		return nil
 	}
 
-	if strings.HasPrefix(id, service.CronjobResourceIDPrefix) {
-		return errors.New("cron jobs cannot be restarted")
+	return provider.PaletteCommands(r)
+}
+
+func (rs *Service) providerFor(id string) (ResourceProvider, bool) {
+	for _, provider := range rs.providers {
+		if provider.Matches(id) {
+			return provider, true
+		}
 	}
 
-	return errors.New("unknown resource type")
-}
\ No newline at end of file
+	return nil, false
+}
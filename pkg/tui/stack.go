@@ -20,6 +20,12 @@ type StackModel struct {
 
 	width  int
 	height int
+
+	// GlobalPalette, when set, is invoked on Ctrl+P from any stack frame so
+	// the command palette is reachable without first drilling into a
+	// resource row. cmd sets this once at startup; pkg/tui can't construct
+	// the palette itself without importing cmd.
+	GlobalPalette func() tea.Cmd
 }
 
 type ModelWithCmd struct {
@@ -66,8 +72,18 @@ func (m *StackModel) Push(model ModelWithCmd) tea.Cmd {
 	return tea.Batch(model.Model.Init(), func() tea.Msg { return m.StackSizeMsg() })
 }
 
+// Closer is implemented by a stack frame that holds a resource needing
+// explicit teardown (e.g. an open log stream's context) when the frame is
+// popped, rather than just garbage collected.
+type Closer interface {
+	Close()
+}
+
 func (m *StackModel) Pop() {
 	if len(m.stack) > 0 {
+		if closer, ok := m.stack[len(m.stack)-1].Model.(Closer); ok {
+			closer.Close()
+		}
 		m.stack = m.stack[:len(m.stack)-1]
 	}
 }
@@ -98,6 +114,10 @@ func (m *StackModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			}
 			return m, m.Init()
+		case tea.KeyCtrlP:
+			if m.GlobalPalette != nil {
+				return m, m.GlobalPalette()
+			}
 		case tea.KeyCtrlS:
 			// copy command to clipboard
 			if len(m.stack) > 0 {